@@ -7,7 +7,8 @@ import (
 	"time"
 
 	"k8s.io/client-go/kubernetes"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	configv1 "github.com/openshift/api/config/v1"
 	"github.com/openshift/library-go/pkg/config/helpers"
@@ -28,7 +29,8 @@ func main() {
 	config.QPS = 1
 	config.Burst = 1
 
-	fmt.Println("creating the k8s client set for the config\n")
+	fmt.Println("creating the k8s client set for the config")
+	fmt.Println()
 	clientset, err := kubernetes.NewForConfig(config)
 	if err != nil {
 		panic(err.Error())
@@ -36,10 +38,18 @@ func main() {
 
 	for {
 		fmt.Println("about to LIST secrets in the default namespace")
-		ret, err := clientset.CoreV1().Secrets("default").List(context.TODO(), metav1.ListOptions{})
+		// stream the list so that only one secret at a time is held in memory
+		count := 0
+		_, err := clientset.CoreV1().RESTClient().Get().
+			Namespace("default").
+			Resource("secrets").
+			StreamList(context.TODO(), func() runtime.Object { return &corev1.Secret{} }, func(runtime.Object) error {
+				count++
+				return nil
+			})
 		if err != nil {
 			fmt.Println(fmt.Sprintf("error while listing secrets, err = %v", err))
 		}
-		fmt.Println(fmt.Sprintf("found %d secrets in the default namespace", len(ret.Items)))
+		fmt.Println(fmt.Sprintf("found %d secrets in the default namespace", count))
 	}
 }